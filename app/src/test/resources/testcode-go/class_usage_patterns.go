@@ -50,3 +50,21 @@ func usageExample() {
 	var myBase BaseStruct
 	var ptr *BaseStruct = &BaseStruct{Value: 5}
 }
+
+// WrapperStruct embeds ExtendedStruct, so it inherits BaseStruct's promoted
+// members through two levels of embedding.
+type WrapperStruct struct {
+	ExtendedStruct
+}
+
+// callPromotedMembers exercises promoted methods and fields reached through
+// one and two levels of embedding.
+func callPromotedMembers() {
+	e := ExtendedStruct{BaseStruct: BaseStruct{Value: 7}}
+	e.InstanceMethod() // promoted from BaseStruct
+	_ = e.Value        // promoted from BaseStruct
+
+	w := WrapperStruct{ExtendedStruct: e}
+	w.InstanceMethod() // promoted through ExtendedStruct from BaseStruct
+	_ = w.Value        // promoted through ExtendedStruct from BaseStruct
+}
@@ -0,0 +1,58 @@
+package main
+
+// Address is a plain nested struct used by Person below.
+type Address struct {
+	City string
+	Zip  string
+}
+
+// Person exercises the range of field kinds a fill-struct action has to
+// handle: primitives, a string, a nested struct, a pointer, a slice, a map,
+// an array, a channel, a func, an interface, and an embedded field.
+type Person struct {
+	BaseStruct
+	Name    string
+	Age     int
+	Height  float64
+	Home    Address
+	Friend  *Person
+	Tags    []string
+	Aliases map[string]string
+	Scores  [3]int
+	Done    chan bool
+	OnGreet func() string
+	Extra   interface{}
+}
+
+// PointerContainer embeds BaseStruct by pointer, so its zero value is nil,
+// not BaseStruct{}.
+type PointerContainer struct {
+	*BaseStruct
+	Label string
+}
+
+func compositeLiteralsUsageExample() {
+	// Empty composite literal: every field needs a zero value filled in.
+	var empty Person = Person{}
+
+	// Partially filled: existing fields must be preserved, only the rest
+	// should be completed.
+	partial := Person{
+		Name: "Ada",
+		Age:  36,
+	}
+
+	// Already-complete literal, included for contrast.
+	full := &ExtendedStruct{
+		BaseStruct: BaseStruct{Value: 1},
+		field:      &BaseStruct{Value: 2},
+	}
+
+	// Pointer-embedded field: its zero value must be nil.
+	pointerEmbedded := PointerContainer{}
+
+	_ = empty
+	_ = partial
+	_ = full
+	_ = pointerEmbedded
+}
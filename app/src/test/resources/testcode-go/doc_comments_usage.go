@@ -0,0 +1,39 @@
+// Package main demonstrates the GoDoc comment conventions (the
+// "Symbol is/are ..." opening sentence, headings, lists, code blocks and
+// doc links) that a structured doc extractor needs to parse.
+package main
+
+// Widget represents a configurable unit of work.
+//
+// # Lifecycle
+//
+// A Widget moves through three states: created, started, and stopped.
+// Callers must call [Widget.Start] before [Widget.Stop].
+//
+// Example usage:
+//
+//	w := NewWidget("demo")
+//	w.Start()
+//	defer w.Stop()
+//
+//   - created: the zero value, not yet started
+//   - started: accepting work
+//   - stopped: no longer accepting work
+type Widget struct {
+	Name string
+}
+
+// NewWidget creates a new Widget with the given name.
+func NewWidget(name string) *Widget {
+	return &Widget{Name: name}
+}
+
+// Start transitions the Widget into the started state.
+//
+// See [Widget] for the full lifecycle.
+func (w *Widget) Start() {}
+
+// Stop transitions the Widget into the stopped state.
+//
+// It is safe to call Stop more than once.
+func (w *Widget) Stop() {}
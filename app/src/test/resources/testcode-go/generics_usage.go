@@ -0,0 +1,53 @@
+package main
+
+// basicStruct is a generic struct parameterized over a single type.
+type basicStruct[T any] struct {
+	foo T
+}
+
+// Number is a type constraint permitting int, int64, float64 and types derived from them.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+// pairStruct is a generic struct with two type parameters, one of which
+// is constrained rather than `any`.
+type pairStruct[K comparable, V Number] struct {
+	key   K
+	value V
+}
+
+// Identity is a generic function returning its argument unchanged.
+func Identity[T any](v T) T {
+	return v
+}
+
+// Sum is a generic function constrained to Number.
+func Sum[T Number](values []T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// Get is a generic method on basicStruct.
+func (b basicStruct[T]) Get() T {
+	return b.foo
+}
+
+func genericsUsageExample() {
+	intStruct := basicStruct[int]{foo: 1}
+	stringStruct := basicStruct[string]{foo: "hello"}
+
+	_ = intStruct.Get()
+	_ = stringStruct.Get()
+
+	_ = Identity[int](5)
+	_ = Identity("implicit")
+
+	p := pairStruct[string, int]{key: "answer", value: 42}
+	_ = p
+
+	_ = Sum([]int{1, 2, 3})
+}
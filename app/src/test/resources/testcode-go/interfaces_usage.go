@@ -0,0 +1,54 @@
+package main
+
+// Stringer is satisfied by any type with a String method.
+type Stringer interface {
+	String() string
+}
+
+// Animal is satisfied by Stringer types that can also speak.
+type Animal interface {
+	Stringer
+	Speak() string
+}
+
+// S1 satisfies Stringer with a value receiver.
+type S1 struct {
+	Name string
+}
+
+// String implements Stringer for S1.
+func (s S1) String() string {
+	return s.Name
+}
+
+// S2 satisfies Animal with a pointer receiver, so only *S2 implements it.
+type S2 struct {
+	Name string
+}
+
+// String implements Stringer for *S2.
+func (s *S2) String() string {
+	return s.Name
+}
+
+// Speak implements Animal for *S2.
+func (s *S2) Speak() string {
+	return s.Name + " speaks"
+}
+
+// EmbeddedAnimal embeds S2, so it promotes *S2's methods and therefore also
+// satisfies Animal through *EmbeddedAnimal.
+type EmbeddedAnimal struct {
+	S2
+}
+
+func interfacesUsageExample() {
+	var s Stringer = S1{Name: "value-receiver"}
+	_ = s.String()
+
+	var a Animal = &S2{Name: "pointer-receiver"}
+	_ = a.Speak()
+
+	var ea Animal = &EmbeddedAnimal{S2: S2{Name: "promoted"}}
+	_ = ea.Speak()
+}